@@ -15,6 +15,7 @@
 package pdatautil // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/pdatautil"
 
 import (
+	"bytes"
 	"encoding/binary"
 	"hash"
 	"math"
@@ -41,107 +42,135 @@ var (
 	valSliceSuffix  = []byte{'\xff'}
 )
 
-type hashWriter struct {
-	h       hash.Hash
+// canonicalWriter writes the canonical, prefix-tagged, key-sorted byte
+// encoding of a pcommon.Map/pcommon.Value to an underlying io.Writer (a
+// hash.Hash when hashing, or a *bytes.Buffer when only the encoding itself
+// is wanted).
+type canonicalWriter struct {
+	w       hashSink
 	strBuf  []byte
 	keysBuf []string
-	sumHash []byte
 	numBuf  []byte
 }
 
-func newHashWriter() *hashWriter {
-	return &hashWriter{
-		h:       xxhash.New(),
+// hashSink is the subset of io.Writer that both hash.Hash and
+// *bytes.Buffer satisfy.
+type hashSink interface {
+	Write(p []byte) (int, error)
+}
+
+func newCanonicalWriter(w hashSink) *canonicalWriter {
+	return &canonicalWriter{
+		w:       w,
 		strBuf:  make([]byte, 0, 128),
 		keysBuf: make([]string, 0, 16),
-		sumHash: make([]byte, 0, 16),
 		numBuf:  make([]byte, 8),
 	}
 }
 
-var hashWriterPool = &sync.Pool{
-	New: func() interface{} { return newHashWriter() },
-}
-
-// MapHash return a hash for the provided map.
-// Maps with the same underlying key/value pairs in different order produce the same deterministic hash value.
-func MapHash(m pcommon.Map) [16]byte {
-	hw := hashWriterPool.Get().(*hashWriter)
-	defer hashWriterPool.Put(hw)
-	hw.h.Reset()
-	hw.writeMapHash(m)
-	return hw.hashSum128()
-}
-
-// ValueHash return a hash for the provided pcommon.Value.
-func ValueHash(v pcommon.Value) [16]byte {
-	hw := hashWriterPool.Get().(*hashWriter)
-	defer hashWriterPool.Put(hw)
-	hw.h.Reset()
-	hw.writeValueHash(v)
-	return hw.hashSum128()
-}
-
-func (hw *hashWriter) writeMapHash(m pcommon.Map) {
-	hw.keysBuf = hw.keysBuf[:0]
+func (cw *canonicalWriter) writeMapHash(m pcommon.Map) {
+	// Nested maps share keysBuf: each call appends its keys after the
+	// caller's and trims them off again before returning.
+	start := len(cw.keysBuf)
 	m.Range(func(k string, v pcommon.Value) bool {
-		hw.keysBuf = append(hw.keysBuf, k)
+		cw.keysBuf = append(cw.keysBuf, k)
 		return true
 	})
-	sort.Strings(hw.keysBuf)
-	for _, k := range hw.keysBuf {
+	keys := cw.keysBuf[start:]
+	sort.Strings(keys)
+	defer func() { cw.keysBuf = cw.keysBuf[:start] }()
+	for _, k := range keys {
 		v, _ := m.Get(k)
-		hw.strBuf = hw.strBuf[:0]
-		hw.strBuf = append(hw.strBuf, keyPrefix...)
-		hw.strBuf = append(hw.strBuf, k...)
-		hw.h.Write(hw.strBuf)
-		hw.writeValueHash(v)
+		cw.strBuf = cw.strBuf[:0]
+		cw.strBuf = append(cw.strBuf, keyPrefix...)
+		cw.strBuf = append(cw.strBuf, k...)
+		_, _ = cw.w.Write(cw.strBuf)
+		cw.writeValueHash(v)
 	}
 }
 
-func (hw *hashWriter) writeSliceHash(sl pcommon.Slice) {
+func (cw *canonicalWriter) writeSliceHash(sl pcommon.Slice) {
 	for i := 0; i < sl.Len(); i++ {
-		hw.writeValueHash(sl.At(i))
+		cw.writeValueHash(sl.At(i))
 	}
 }
 
-func (hw *hashWriter) writeValueHash(v pcommon.Value) {
+func (cw *canonicalWriter) writeValueHash(v pcommon.Value) {
 	switch v.Type() {
 	case pcommon.ValueTypeStr:
-		hw.strBuf = hw.strBuf[:0]
-		hw.strBuf = append(hw.strBuf, valStrPrefix...)
-		hw.strBuf = append(hw.strBuf, v.Str()...)
-		hw.h.Write(hw.strBuf)
+		cw.strBuf = cw.strBuf[:0]
+		cw.strBuf = append(cw.strBuf, valStrPrefix...)
+		cw.strBuf = append(cw.strBuf, v.Str()...)
+		_, _ = cw.w.Write(cw.strBuf)
 	case pcommon.ValueTypeBool:
 		if v.Bool() {
-			hw.h.Write(valBoolTrue)
+			_, _ = cw.w.Write(valBoolTrue)
 		} else {
-			hw.h.Write(valBoolFalse)
+			_, _ = cw.w.Write(valBoolFalse)
 		}
 	case pcommon.ValueTypeInt:
-		hw.h.Write(valIntPrefix)
-		binary.LittleEndian.PutUint64(hw.numBuf, uint64(v.Int()))
-		hw.h.Write(hw.numBuf)
+		_, _ = cw.w.Write(valIntPrefix)
+		binary.LittleEndian.PutUint64(cw.numBuf, uint64(v.Int()))
+		_, _ = cw.w.Write(cw.numBuf)
 	case pcommon.ValueTypeDouble:
-		hw.h.Write(valDoublePrefix)
-		binary.LittleEndian.PutUint64(hw.numBuf, math.Float64bits(v.Double()))
-		hw.h.Write(hw.numBuf)
+		_, _ = cw.w.Write(valDoublePrefix)
+		binary.LittleEndian.PutUint64(cw.numBuf, math.Float64bits(v.Double()))
+		_, _ = cw.w.Write(cw.numBuf)
 	case pcommon.ValueTypeMap:
-		hw.h.Write(valMapPrefix)
-		hw.writeMapHash(v.Map())
-		hw.h.Write(valMapSuffix)
+		_, _ = cw.w.Write(valMapPrefix)
+		cw.writeMapHash(v.Map())
+		_, _ = cw.w.Write(valMapSuffix)
 	case pcommon.ValueTypeSlice:
-		hw.h.Write(valSlicePrefix)
-		hw.writeSliceHash(v.Slice())
-		hw.h.Write(valSliceSuffix)
+		_, _ = cw.w.Write(valSlicePrefix)
+		cw.writeSliceHash(v.Slice())
+		_, _ = cw.w.Write(valSliceSuffix)
 	case pcommon.ValueTypeBytes:
-		hw.h.Write(valBytesPrefix)
-		hw.h.Write(v.Bytes().AsRaw())
+		_, _ = cw.w.Write(valBytesPrefix)
+		_, _ = cw.w.Write(v.Bytes().AsRaw())
 	case pcommon.ValueTypeEmpty:
-		hw.h.Write(valEmpty)
+		_, _ = cw.w.Write(valEmpty)
 	}
 }
 
+// hashWriter wraps a canonicalWriter targeting a hash.Hash, the shape used
+// by MapHash/ValueHash and HashBuilder.
+type hashWriter struct {
+	h hash.Hash
+	*canonicalWriter
+	sumHash []byte
+}
+
+func newHashWriter(h hash.Hash) *hashWriter {
+	return &hashWriter{
+		h:               h,
+		canonicalWriter: newCanonicalWriter(h),
+		sumHash:         make([]byte, 0, 16),
+	}
+}
+
+var hashWriterPool = &sync.Pool{
+	New: func() interface{} { return newHashWriter(xxhash.New()) },
+}
+
+// MapHash return a hash for the provided map.
+// Maps with the same underlying key/value pairs in different order produce the same deterministic hash value.
+func MapHash(m pcommon.Map) [16]byte {
+	hw := hashWriterPool.Get().(*hashWriter)
+	defer hashWriterPool.Put(hw)
+	hw.h.Reset()
+	hw.writeMapHash(m)
+	return hw.hashSum128()
+}
+
+// ValueHash return a hash for the provided pcommon.Value.
+func ValueHash(v pcommon.Value) [16]byte {
+	hw := hashWriterPool.Get().(*hashWriter)
+	defer hashWriterPool.Put(hw)
+	hw.h.Reset()
+	hw.writeValueHash(v)
+	return hw.hashSum128()
+}
+
 // hashSum128 returns a [16]byte hash sum.
 func (hw *hashWriter) hashSum128() [16]byte {
 	b := hw.sumHash[:0]
@@ -155,3 +184,89 @@ func (hw *hashWriter) hashSum128() [16]byte {
 	copy(res[:], b)
 	return res
 }
+
+// hashOptions configures MapHashWith/ValueHashWith. newHashOptions starts
+// from the same xxhash-based default used by MapHash/ValueHash.
+type hashOptions struct {
+	newHasher func() hash.Hash
+	hasCustom bool
+}
+
+// HashOption customizes the hash.Hash implementation used by
+// MapHashWith/ValueHashWith.
+type HashOption func(*hashOptions)
+
+// WithHasher selects the hash.Hash implementation to use, e.g.
+// WithHasher(sha256.New) or WithHasher(func() hash.Hash { return fnv.New32a() }).
+func WithHasher(newHasher func() hash.Hash) HashOption {
+	return func(o *hashOptions) {
+		o.newHasher = newHasher
+		o.hasCustom = true
+	}
+}
+
+func newHashOptions(opts []HashOption) hashOptions {
+	o := hashOptions{newHasher: func() hash.Hash { return xxhash.New() }}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// sumDigest returns h's digest. For the default xxhash hasher (hasCustom
+// false) it reproduces the same "write an extra byte, sum twice" trick
+// hashSum128 uses, so the zero-config digest is byte-for-byte identical to
+// MapHash/ValueHash's 16-byte sum. For a caller-supplied hasher it just
+// returns the hasher's natural-length digest.
+func sumDigest(h hash.Hash, hasCustom bool) []byte {
+	b := h.Sum(nil)
+	if hasCustom {
+		return b
+	}
+	_, _ = h.Write(extraByte)
+	return h.Sum(b)
+}
+
+// MapHashWith returns a hash for the provided map, computed with the
+// hash.Hash constructed by the supplied options. With no options, the
+// returned digest is the same 16 bytes MapHash returns, just as a []byte
+// instead of a [16]byte. With WithHasher, the digest has whatever length
+// the chosen hash.Hash produces.
+func MapHashWith(m pcommon.Map, opts ...HashOption) []byte {
+	o := newHashOptions(opts)
+	h := o.newHasher()
+	cw := newCanonicalWriter(h)
+	cw.writeMapHash(m)
+	return sumDigest(h, o.hasCustom)
+}
+
+// ValueHashWith returns a hash for the provided pcommon.Value, computed
+// with the hash.Hash constructed by the supplied options. See MapHashWith
+// for the zero-config/WithHasher digest length contract.
+func ValueHashWith(v pcommon.Value, opts ...HashOption) []byte {
+	o := newHashOptions(opts)
+	h := o.newHasher()
+	cw := newCanonicalWriter(h)
+	cw.writeValueHash(v)
+	return sumDigest(h, o.hasCustom)
+}
+
+// MarshalCanonical returns the canonical, prefix-tagged, key-sorted byte
+// encoding that ValueHash/ValueHashWith feed to the underlying hasher, without
+// hashing it. Callers that need their own content-addressable index (dedup
+// processors, caches, exemplar keying) can use this instead of
+// re-implementing the encoding.
+func MarshalCanonical(v pcommon.Value) []byte {
+	var buf bytes.Buffer
+	cw := newCanonicalWriter(&buf)
+	cw.writeValueHash(v)
+	return buf.Bytes()
+}
+
+// MarshalMapCanonical is MarshalCanonical for a pcommon.Map.
+func MarshalMapCanonical(m pcommon.Map) []byte {
+	var buf bytes.Buffer
+	cw := newCanonicalWriter(&buf)
+	cw.writeMapHash(m)
+	return buf.Bytes()
+}
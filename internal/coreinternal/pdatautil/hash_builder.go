@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pdatautil // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/pdatautil"
+
+import (
+	"encoding"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// HashBuilder incrementally accumulates a MapHash-compatible digest from
+// disjoint pieces (resource attrs, scope attrs, selected record attrs, a
+// metric name, ...) without first merging them into a single pcommon.Map.
+//
+// Keys within a single AddMap call are sorted for determinism, matching
+// MapHash. Across separate Add* calls no reordering happens: the digest
+// depends on the order the caller adds things in.
+//
+// A HashBuilder wraps a pooled hashWriter; call Release once the digest
+// has been read to return it to the pool. Reset reuses the same
+// HashBuilder for a new digest without returning it to the pool. Using a
+// HashBuilder after Release panics.
+type HashBuilder struct {
+	hw *hashWriter
+}
+
+// NewHashBuilder returns a HashBuilder backed by a hashWriter drawn from
+// the same pool MapHash/ValueHash use.
+func NewHashBuilder() *HashBuilder {
+	hw := hashWriterPool.Get().(*hashWriter)
+	hw.h.Reset()
+	return &HashBuilder{hw: hw}
+}
+
+func (b *HashBuilder) checkNotReleased() {
+	if b.hw == nil {
+		panic("pdatautil: HashBuilder used after Release")
+	}
+}
+
+// AddMap feeds m's key/value pairs, sorted by key, into the digest.
+func (b *HashBuilder) AddMap(m pcommon.Map) *HashBuilder {
+	b.checkNotReleased()
+	b.hw.writeMapHash(m)
+	return b
+}
+
+// AddValue feeds v into the digest.
+func (b *HashBuilder) AddValue(v pcommon.Value) *HashBuilder {
+	b.checkNotReleased()
+	b.hw.writeValueHash(v)
+	return b
+}
+
+// AddKeyedValue feeds a single key/value pair into the digest, using the
+// same key-prefixed encoding AddMap uses for each of its entries.
+func (b *HashBuilder) AddKeyedValue(k string, v pcommon.Value) *HashBuilder {
+	b.checkNotReleased()
+	b.hw.strBuf = b.hw.strBuf[:0]
+	b.hw.strBuf = append(b.hw.strBuf, keyPrefix...)
+	b.hw.strBuf = append(b.hw.strBuf, k...)
+	_, _ = b.hw.w.Write(b.hw.strBuf)
+	b.hw.writeValueHash(v)
+	return b
+}
+
+// AddString feeds a bare string into the digest, using the same encoding
+// a pcommon.Value of type Str would get.
+func (b *HashBuilder) AddString(s string) *HashBuilder {
+	b.checkNotReleased()
+	b.hw.strBuf = b.hw.strBuf[:0]
+	b.hw.strBuf = append(b.hw.strBuf, valStrPrefix...)
+	b.hw.strBuf = append(b.hw.strBuf, s...)
+	_, _ = b.hw.w.Write(b.hw.strBuf)
+	return b
+}
+
+// Sum128 returns the digest of everything added so far. Unlike the
+// mutating write-an-extra-byte trick MapHash/ValueHash use internally,
+// Sum128 does not change the builder's state: it snapshots the
+// underlying hasher before deriving the second half of the digest and
+// restores it afterwards, so it is safe to call as a mid-sequence
+// checkpoint and keep adding more afterward. This relies on the pooled
+// hasher (xxhash.Digest) implementing encoding.BinaryMarshaler/
+// BinaryUnmarshaler to snapshot its state; if it didn't, Sum128 would
+// fall back to the mutating behavior.
+func (b *HashBuilder) Sum128() [16]byte {
+	b.checkNotReleased()
+	return snapshotSum128(b.hw.h)
+}
+
+func snapshotSum128(h interface {
+	Sum(b []byte) []byte
+	Write(p []byte) (int, error)
+}) [16]byte {
+	if snapshotter, ok := h.(interface {
+		encoding.BinaryMarshaler
+		encoding.BinaryUnmarshaler
+	}); ok {
+		if state, err := snapshotter.MarshalBinary(); err == nil {
+			defer func() { _ = snapshotter.UnmarshalBinary(state) }()
+		}
+	}
+
+	b := h.Sum(nil)
+	_, _ = h.Write(extraByte)
+	b = h.Sum(b)
+
+	res := [16]byte{}
+	copy(res[:], b)
+	return res
+}
+
+// Reset clears the digest so the HashBuilder can be reused for a new one.
+func (b *HashBuilder) Reset() {
+	b.checkNotReleased()
+	b.hw.h.Reset()
+}
+
+// Release resets the HashBuilder and returns its underlying hashWriter to
+// the shared pool. Safe to call more than once; the second and later
+// calls are no-ops. The HashBuilder must not be used afterwards.
+func (b *HashBuilder) Release() {
+	if b.hw == nil {
+		return
+	}
+	b.hw.h.Reset()
+	hashWriterPool.Put(b.hw)
+	b.hw = nil
+}
@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pdatautil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestHashBuilderAddMapMatchesMapHash(t *testing.T) {
+	m := buildTestMap()
+
+	hb := NewHashBuilder()
+	defer hb.Release()
+	got := hb.AddMap(m).Sum128()
+
+	want := MapHash(m)
+	assert.Equal(t, want, got)
+}
+
+func TestHashBuilderOrderSensitiveAcrossCalls(t *testing.T) {
+	resource := pcommon.NewMap()
+	resource.PutStr("service.name", "foo")
+	scope := pcommon.NewMap()
+	scope.PutStr("scope.key", "bar")
+
+	hb1 := NewHashBuilder()
+	sum1 := hb1.AddMap(resource).AddMap(scope).AddString("metric.name").Sum128()
+	hb1.Release()
+
+	hb2 := NewHashBuilder()
+	sum2 := hb2.AddMap(scope).AddMap(resource).AddString("metric.name").Sum128()
+	hb2.Release()
+
+	assert.NotEqual(t, sum1, sum2, "swapping call order should change the digest")
+}
+
+func TestHashBuilderAddKeyedValueMatchesSingleEntryMap(t *testing.T) {
+	v := pcommon.NewValueInt(42)
+
+	hb := NewHashBuilder()
+	defer hb.Release()
+	got := hb.AddKeyedValue("count", v).Sum128()
+
+	m := pcommon.NewMap()
+	m.PutInt("count", 42)
+	want := MapHash(m)
+
+	assert.Equal(t, want, got)
+}
+
+func TestHashBuilderReset(t *testing.T) {
+	hb := NewHashBuilder()
+	defer hb.Release()
+
+	hb.AddString("first")
+	firstSum := hb.Sum128()
+
+	hb.Reset()
+	hb.AddString("first")
+	secondSum := hb.Sum128()
+
+	assert.Equal(t, firstSum, secondSum)
+}
+
+func TestHashBuilderSum128IsNonMutatingCheckpoint(t *testing.T) {
+	m1 := buildTestMap()
+	m2 := pcommon.NewMap()
+	m2.PutStr("other", "value")
+
+	hbChecked := NewHashBuilder()
+	defer hbChecked.Release()
+	hbChecked.AddMap(m1)
+	_ = hbChecked.Sum128() // mid-sequence checkpoint peek
+	hbChecked.AddMap(m2)
+	checkedSum := hbChecked.Sum128()
+
+	hbPlain := NewHashBuilder()
+	defer hbPlain.Release()
+	hbPlain.AddMap(m1)
+	hbPlain.AddMap(m2)
+	plainSum := hbPlain.Sum128()
+
+	assert.Equal(t, plainSum, checkedSum, "calling Sum128 mid-sequence must not change the final digest")
+}
+
+func TestHashBuilderReleaseIsIdempotent(t *testing.T) {
+	hb := NewHashBuilder()
+	hb.AddString("x")
+	hb.Release()
+
+	assert.NotPanics(t, func() { hb.Release() })
+}
+
+func TestHashBuilderUseAfterReleasePanics(t *testing.T) {
+	hb := NewHashBuilder()
+	hb.Release()
+
+	assert.Panics(t, func() { hb.AddString("x") })
+}
@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pdatautil
+
+import (
+	"crypto/sha256"
+	"hash"
+	"hash/fnv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func buildTestMap() pcommon.Map {
+	m := pcommon.NewMap()
+	m.PutStr("b", "two")
+	m.PutInt("a", 1)
+	inner := m.PutEmptyMap("c")
+	inner.PutBool("nested", true)
+	return m
+}
+
+func TestMapHashWithDefaultMatchesMapHash(t *testing.T) {
+	m := buildTestMap()
+	got := MapHashWith(m)
+	want := MapHash(m)
+	assert.Equal(t, want[:], got)
+}
+
+func TestMapHashWithCustomHasher(t *testing.T) {
+	m := buildTestMap()
+	got := MapHashWith(m, WithHasher(sha256.New))
+	assert.Len(t, got, sha256.Size)
+
+	// deterministic regardless of key order
+	m2 := pcommon.NewMap()
+	m2.PutInt("a", 1)
+	m2.PutStr("b", "two")
+	inner := m2.PutEmptyMap("c")
+	inner.PutBool("nested", true)
+	assert.Equal(t, got, MapHashWith(m2, WithHasher(sha256.New)))
+}
+
+func TestValueHashWithCustomHasher(t *testing.T) {
+	v := pcommon.NewValueStr("hello")
+	got := ValueHashWith(v, WithHasher(func() hash.Hash { return fnv.New32a() }))
+	assert.Len(t, got, 4)
+}
+
+func TestMarshalCanonicalMatchesHashInput(t *testing.T) {
+	v := pcommon.NewValueStr("hello")
+	encoded := MarshalCanonical(v)
+
+	h := fnv.New32a()
+	_, _ = h.Write(encoded)
+	want := h.Sum(nil)
+
+	got := ValueHashWith(v, WithHasher(func() hash.Hash { return fnv.New32a() }))
+	assert.Equal(t, want, got)
+}
+
+func TestMarshalMapCanonicalDeterministic(t *testing.T) {
+	m1 := buildTestMap()
+	m2 := pcommon.NewMap()
+	m2.PutInt("a", 1)
+	m2.PutStr("b", "two")
+	inner := m2.PutEmptyMap("c")
+	inner.PutBool("nested", true)
+
+	assert.Equal(t, MarshalMapCanonical(m1), MarshalMapCanonical(m2))
+}
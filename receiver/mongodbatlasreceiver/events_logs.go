@@ -0,0 +1,178 @@
+// Copyright  OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodbatlasreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbatlasreceiver"
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbatlasreceiver/internal/model"
+)
+
+// adminAPITimestampLayouts are the timestamp formats used across the Atlas
+// Admin API (Events, Alerts, Access Tracking).
+var adminAPITimestampLayouts = []string{
+	time.RFC3339,
+	time.RFC3339Nano,
+}
+
+func parseAdminAPITimestamp(logger *zap.Logger, ts string) pcommon.Timestamp {
+	for _, layout := range adminAPITimestampLayouts {
+		if t, err := time.Parse(layout, ts); err == nil {
+			return pcommon.NewTimestampFromTime(t)
+		}
+	}
+	logger.Debug("unrecognized Atlas Admin API timestamp, leaving timestamp unset", zap.String("timestamp", ts))
+	return 0
+}
+
+func putProjectResourceAttrs(rl plog.ResourceLogs, pc ProjectContext) pcommon.Map {
+	resourceAttrs := rl.Resource().Attributes()
+	resourceAttrs.PutStr("mongodb_atlas.org", pc.orgName)
+	resourceAttrs.PutStr("mongodb_atlas.project", pc.Name)
+	return resourceAttrs
+}
+
+// projectEventToLogData converts project/organization Events, as returned
+// by the Atlas Events Admin API, into plog.Logs. Events carry project-wide
+// activity (cluster changes, user/permission changes, billing, etc.) and
+// are not tied to a single host.
+func projectEventToLogData(logger *zap.Logger, events []model.Event, pc ProjectContext) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	putProjectResourceAttrs(rl, pc)
+
+	sl := rl.ScopeLogs().AppendEmpty()
+	for _, event := range events {
+		lr := sl.LogRecords().AppendEmpty()
+		lr.SetTimestamp(parseAdminAPITimestamp(logger, event.Created))
+		lr.Body().SetStr(event.EventTypeName)
+		lr.SetSeverityNumber(plog.SeverityNumberInfo)
+		lr.SetSeverityText("INFO")
+
+		attrs := lr.Attributes()
+		attrs.PutStr("mongodb_atlas.event.id", event.ID)
+		attrs.PutStr("mongodb_atlas.event.type", event.EventTypeName)
+		if event.UserID != "" {
+			attrs.PutStr("mongodb_atlas.event.user_id", event.UserID)
+		}
+		if event.Username != "" {
+			attrs.PutStr("mongodb_atlas.event.username", event.Username)
+		}
+		if event.TargetUsername != "" {
+			attrs.PutStr("mongodb_atlas.event.target_username", event.TargetUsername)
+		}
+		if event.RemoteAddress != "" {
+			attrs.PutStr("mongodb_atlas.event.remote_address", event.RemoteAddress)
+		}
+	}
+
+	return ld
+}
+
+// alertStatusToSeverity maps an Atlas alert's status to an OTel severity.
+// Open/in-progress alerts are warnings; resolved/closed alerts are
+// downgraded back to info.
+var alertStatusToSeverity = map[string]plog.SeverityNumber{
+	"OPEN":      plog.SeverityNumberWarn,
+	"TRACKING":  plog.SeverityNumberWarn,
+	"CLOSED":    plog.SeverityNumberInfo,
+	"CANCELLED": plog.SeverityNumberInfo,
+}
+
+// alertToLogData converts project Alerts, as returned by the Atlas Alerts
+// Admin API, into plog.Logs.
+func alertToLogData(logger *zap.Logger, alerts []model.Alert, pc ProjectContext) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	putProjectResourceAttrs(rl, pc)
+
+	sl := rl.ScopeLogs().AppendEmpty()
+	for _, alert := range alerts {
+		lr := sl.LogRecords().AppendEmpty()
+		lr.SetTimestamp(parseAdminAPITimestamp(logger, alert.Created))
+		lr.Body().SetStr(alert.EventTypeName)
+
+		severity, ok := alertStatusToSeverity[alert.Status]
+		if !ok {
+			severity = plog.SeverityNumberInfo
+		}
+		lr.SetSeverityNumber(severity)
+		lr.SetSeverityText(alert.Status)
+
+		attrs := lr.Attributes()
+		attrs.PutStr("mongodb_atlas.alert.id", alert.ID)
+		attrs.PutStr("mongodb_atlas.alert.config_id", alert.AlertConfigID)
+		attrs.PutStr("mongodb_atlas.alert.type", alert.EventTypeName)
+		attrs.PutStr("mongodb_atlas.alert.status", alert.Status)
+		if alert.ClusterName != "" {
+			attrs.PutStr("mongodb_atlas.cluster", alert.ClusterName)
+		}
+		if alert.ReplicaSetName != "" {
+			attrs.PutStr("mongodb_atlas.replica_set", alert.ReplicaSetName)
+		}
+		if alert.MetricName != "" {
+			attrs.PutStr("mongodb_atlas.alert.metric", alert.MetricName)
+		}
+		if alert.Comment != "" {
+			attrs.PutStr("mongodb_atlas.alert.comment", alert.Comment)
+		}
+		if alert.Resolved != "" {
+			attrs.PutStr("mongodb_atlas.alert.resolved", alert.Resolved)
+		}
+	}
+
+	return ld
+}
+
+// accessLogToLogData converts Database Access History entries, as returned
+// by the Atlas Access Tracking Admin API, into plog.Logs. Access history
+// records every authentication attempt against the project's clusters and
+// is a security-relevant companion to the audit log.
+func accessLogToLogData(logger *zap.Logger, entries []model.AccessLogEntry, pc ProjectContext, clusterName string) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	resourceAttrs := putProjectResourceAttrs(rl, pc)
+	resourceAttrs.PutStr("mongodb_atlas.cluster", clusterName)
+
+	sl := rl.ScopeLogs().AppendEmpty()
+	for _, entry := range entries {
+		lr := sl.LogRecords().AppendEmpty()
+		lr.SetTimestamp(parseAdminAPITimestamp(logger, entry.Timestamp))
+		lr.Body().SetStr(entry.LogLine)
+
+		if entry.AuthResult {
+			lr.SetSeverityNumber(plog.SeverityNumberInfo)
+			lr.SetSeverityText("INFO")
+		} else {
+			lr.SetSeverityNumber(plog.SeverityNumberWarn)
+			lr.SetSeverityText("WARN")
+		}
+
+		attrs := lr.Attributes()
+		attrs.PutStr("mongodb_atlas.access.username", entry.Username)
+		attrs.PutStr("mongodb_atlas.access.hostname", entry.Hostname)
+		attrs.PutStr("mongodb_atlas.access.remote_address", entry.RemoteAddress)
+		attrs.PutBool("mongodb_atlas.access.auth_result", entry.AuthResult)
+		if entry.FailureReason != "" {
+			attrs.PutStr("mongodb_atlas.access.failure_reason", entry.FailureReason)
+		}
+	}
+
+	return ld
+}
@@ -0,0 +1,119 @@
+// Copyright  OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodbatlasreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbatlasreceiver/internal/model"
+)
+
+func testProjectContext() ProjectContext {
+	return ProjectContext{
+		orgName: "Org",
+		Project: mongodbatlas.Project{Name: "Project"},
+	}
+}
+
+func TestProjectEventToLogData(t *testing.T) {
+	event := model.Event{
+		ID:            "5d1d1d1d1d1d1d1d1d1d1d1d",
+		EventTypeName: "HOST_DOWN",
+		Created:       "2022-09-12T18:10:27Z",
+		Username:      "user@example.com",
+	}
+
+	ld := projectEventToLogData(zap.NewNop(), []model.Event{event}, testProjectContext())
+	rl := ld.ResourceLogs().At(0)
+	resourceAttrs := rl.Resource().Attributes()
+	lr := rl.ScopeLogs().At(0).LogRecords().At(0)
+	attrs := lr.Attributes()
+
+	assert.Equal(t, 2, resourceAttrs.Len())
+	assertString(t, resourceAttrs, "mongodb_atlas.org", "Org")
+	assertString(t, resourceAttrs, "mongodb_atlas.project", "Project")
+
+	assert.Equal(t, pcommon.Timestamp(1663006227000000000), lr.Timestamp())
+	assert.Equal(t, "HOST_DOWN", lr.Body().Str())
+	assert.Equal(t, plog.SeverityNumberInfo, lr.SeverityNumber())
+
+	assertString(t, attrs, "mongodb_atlas.event.id", event.ID)
+	assertString(t, attrs, "mongodb_atlas.event.type", "HOST_DOWN")
+	assertString(t, attrs, "mongodb_atlas.event.username", "user@example.com")
+}
+
+func TestAlertToLogData(t *testing.T) {
+	alert := model.Alert{
+		ID:            "62f0c1d1d1d1d1d1d1d1d1d1",
+		AlertConfigID: "62f0c1d1d1d1d1d1d1d1d1d2",
+		EventTypeName: "OUTSIDE_METRIC_THRESHOLD",
+		Status:        "OPEN",
+		Created:       "2022-09-12T18:10:27Z",
+		ClusterName:   "clusterName",
+		MetricName:    "CONNECTIONS",
+	}
+
+	ld := alertToLogData(zap.NewNop(), []model.Alert{alert}, testProjectContext())
+	lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	attrs := lr.Attributes()
+
+	assert.Equal(t, plog.SeverityNumberWarn, lr.SeverityNumber())
+	assert.Equal(t, "OPEN", lr.SeverityText())
+	assert.Equal(t, "OUTSIDE_METRIC_THRESHOLD", lr.Body().Str())
+
+	assertString(t, attrs, "mongodb_atlas.alert.id", alert.ID)
+	assertString(t, attrs, "mongodb_atlas.alert.status", "OPEN")
+	assertString(t, attrs, "mongodb_atlas.cluster", "clusterName")
+	assertString(t, attrs, "mongodb_atlas.alert.metric", "CONNECTIONS")
+
+	alert.Status = "CLOSED"
+	ld = alertToLogData(zap.NewNop(), []model.Alert{alert}, testProjectContext())
+	lr = ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, plog.SeverityNumberInfo, lr.SeverityNumber())
+}
+
+func TestAccessLogToLogData(t *testing.T) {
+	entry := model.AccessLogEntry{
+		Username:      "dbUser",
+		Hostname:      "host1.example.mongodb.net",
+		RemoteAddress: "192.168.1.1",
+		AuthResult:    false,
+		LogLine:       "auth failed",
+		Timestamp:     "2022-09-12T18:10:27Z",
+		FailureReason: "AuthenticationFailed",
+	}
+
+	ld := accessLogToLogData(zap.NewNop(), []model.AccessLogEntry{entry}, testProjectContext(), "clusterName")
+	rl := ld.ResourceLogs().At(0)
+	resourceAttrs := rl.Resource().Attributes()
+	lr := rl.ScopeLogs().At(0).LogRecords().At(0)
+	attrs := lr.Attributes()
+
+	assert.Equal(t, 3, resourceAttrs.Len())
+	assertString(t, resourceAttrs, "mongodb_atlas.cluster", "clusterName")
+
+	assert.Equal(t, plog.SeverityNumberWarn, lr.SeverityNumber())
+	assert.Equal(t, "auth failed", lr.Body().Str())
+
+	assertString(t, attrs, "mongodb_atlas.access.username", "dbUser")
+	assertBool(t, attrs, "mongodb_atlas.access.auth_result", false)
+	assertString(t, attrs, "mongodb_atlas.access.failure_reason", "AuthenticationFailed")
+}
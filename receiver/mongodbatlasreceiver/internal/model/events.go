@@ -0,0 +1,57 @@
+// Copyright  OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbatlasreceiver/internal/model"
+
+// Event is a project or organization event, as returned by the Atlas
+// Events Admin API.
+type Event struct {
+	ID             string `json:"id"`
+	GroupID        string `json:"groupId,omitempty"`
+	OrgID          string `json:"orgId,omitempty"`
+	EventTypeName  string `json:"eventTypeName"`
+	Created        string `json:"created"`
+	UserID         string `json:"userId,omitempty"`
+	RemoteAddress  string `json:"remoteAddress,omitempty"`
+	Username       string `json:"username,omitempty"`
+	TargetUsername string `json:"targetUsername,omitempty"`
+}
+
+// Alert is a project alert, as returned by the Atlas Alerts Admin API.
+type Alert struct {
+	ID             string `json:"id"`
+	GroupID        string `json:"groupId"`
+	AlertConfigID  string `json:"alertConfigId"`
+	EventTypeName  string `json:"eventTypeName"`
+	Status         string `json:"status"`
+	Created        string `json:"created"`
+	Updated        string `json:"updated"`
+	Resolved       string `json:"resolved,omitempty"`
+	ClusterName    string `json:"clusterName,omitempty"`
+	ReplicaSetName string `json:"replicaSetName,omitempty"`
+	MetricName     string `json:"metricName,omitempty"`
+	Comment        string `json:"acknowledgementComment,omitempty"`
+}
+
+// AccessLogEntry is a single entry of the Atlas "Database Access History"
+// for a project, as returned by the Atlas Access Tracking Admin API.
+type AccessLogEntry struct {
+	Username      string `json:"username"`
+	Hostname      string `json:"hostname"`
+	RemoteAddress string `json:"ipAddress"`
+	AuthResult    bool   `json:"authResult"`
+	LogLine       string `json:"logLine"`
+	Timestamp     string `json:"timestamp"`
+	FailureReason string `json:"failureReason,omitempty"`
+}
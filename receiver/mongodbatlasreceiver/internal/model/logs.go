@@ -0,0 +1,79 @@
+// Copyright  OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package model holds the structures used to unmarshal the various log
+// payloads returned by the MongoDB Atlas Logs and Events APIs.
+package model // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbatlasreceiver/internal/model"
+
+// LogTimestamp is the timestamp format shared by mongod log entries and
+// audit log entries.
+type LogTimestamp struct {
+	Date string `json:"$date"`
+}
+
+// LogEntry represents a single line of a mongod or mongos log file, as
+// returned by the Atlas Logs API after gunzip + JSON-lines decoding.
+type LogEntry struct {
+	Timestamp  LogTimestamp           `json:"t"`
+	Severity   string                 `json:"s"`
+	Component  string                 `json:"c"`
+	ID         int64                  `json:"id"`
+	Context    string                 `json:"ctx"`
+	Message    string                 `json:"msg"`
+	Attributes map[string]interface{} `json:"attr"`
+	Raw        string                 `json:"-"`
+}
+
+// ID is the uuid attached to an audit log entry.
+type ID struct {
+	Binary string `json:"binary"`
+	Type   string `json:"type"`
+}
+
+// Address represents a local or remote network endpoint in an audit log
+// entry.
+type Address struct {
+	IP         *string `json:"ip,omitempty"`
+	Port       *int64  `json:"port,omitempty"`
+	SystemUser *bool   `json:"isSystemUser,omitempty"`
+	UnixSocket *string `json:"unix,omitempty"`
+}
+
+// AuditRole is a role granted to, or required of, the principal in an
+// audit log entry.
+type AuditRole struct {
+	Role     string `json:"role"`
+	Database string `json:"db"`
+}
+
+// AuditUser is a user referenced by an audit log entry.
+type AuditUser struct {
+	User     string `json:"user"`
+	Database string `json:"db"`
+}
+
+// AuditLog represents a single line of a mongod audit log, as returned by
+// the Atlas Logs API.
+type AuditLog struct {
+	Timestamp LogTimestamp   `json:"ts"`
+	Type      string         `json:"atype"`
+	ID        *ID            `json:"uuid,omitempty"`
+	Local     Address        `json:"local"`
+	Remote    Address        `json:"remote"`
+	Roles     []AuditRole    `json:"roles"`
+	Users     []AuditUser    `json:"users"`
+	Param     map[string]any `json:"param"`
+	Result    int64          `json:"result"`
+	Raw       string         `json:"-"`
+}
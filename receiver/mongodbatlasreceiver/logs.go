@@ -0,0 +1,271 @@
+// Copyright  OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodbatlasreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbatlasreceiver"
+
+import (
+	"time"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbatlasreceiver/internal/model"
+)
+
+// ProjectContext carries the organization/project metadata that is stamped
+// onto every resource produced while collecting logs for a given Atlas
+// project.
+type ProjectContext struct {
+	orgName string
+	mongodbatlas.Project
+}
+
+// logTimestampLayouts are the date formats observed in the Atlas Logs API
+// output. Older agent versions omit the colon in the timezone offset.
+var logTimestampLayouts = []string{
+	"2006-01-02T15:04:05.000Z07:00",
+	"2006-01-02T15:04:05.000Z0700",
+}
+
+func parseLogTimestamp(ts model.LogTimestamp) pcommon.Timestamp {
+	for _, layout := range logTimestampLayouts {
+		if t, err := time.Parse(layout, ts.Date); err == nil {
+			return pcommon.NewTimestampFromTime(t)
+		}
+	}
+	return 0
+}
+
+var severityTextToNumber = map[string]plog.SeverityNumber{
+	"F": plog.SeverityNumberFatal,
+	"E": plog.SeverityNumberError,
+	"W": plog.SeverityNumberWarn,
+	"I": plog.SeverityNumberInfo,
+	"D": plog.SeverityNumberDebug,
+}
+
+// slowQueryComponents are the mongod log components under which the Atlas
+// agent emits query-shape/performance payloads (query targeting, profiler
+// output mirrored into the log, and write operation timing).
+var slowQueryComponents = map[string]struct{}{
+	"COMMAND":    {},
+	"WRITE":      {},
+	"SLOW_QUERY": {},
+}
+
+// slowQueryAttributes maps the raw attribute keys MongoDB emits on slow
+// query / profiler log lines to the first-class mongodb.* attribute keys we
+// promote them to.
+var slowQueryAttributes = map[string]string{
+	"queryHash":      "mongodb.query_hash",
+	"planSummary":    "mongodb.plan_summary",
+	"docsExamined":   "mongodb.docs_examined",
+	"keysExamined":   "mongodb.keys_examined",
+	"nreturned":      "mongodb.nreturned",
+	"durationMillis": "mongodb.duration_millis",
+	"ns":             "mongodb.namespace",
+	"command":        "mongodb.command",
+}
+
+func mongodbEventToLogData(logger *zap.Logger, logs []model.LogEntry, pc ProjectContext, hostname, logName, clusterName, mongoVersion string, opts ...LogsOption) plog.Logs {
+	cfg := logsOptions{}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	resourceAttrs := rl.Resource().Attributes()
+	resourceAttrs.PutStr("mongodb_atlas.org", pc.orgName)
+	resourceAttrs.PutStr("mongodb_atlas.project", pc.Name)
+	resourceAttrs.PutStr("mongodb_atlas.cluster", clusterName)
+	resourceAttrs.PutStr("mongodb_atlas.host.name", hostname)
+
+	sl := rl.ScopeLogs().AppendEmpty()
+	for _, entry := range logs {
+		lr := sl.LogRecords().AppendEmpty()
+		lr.SetTimestamp(parseLogTimestamp(entry.Timestamp))
+		lr.Body().SetStr(entry.Raw)
+
+		if num, ok := severityTextToNumber[entry.Severity]; ok {
+			lr.SetSeverityNumber(num)
+			lr.SetSeverityText(entry.Severity)
+		} else {
+			logger.Debug("unrecognized severity, leaving severity unset", zap.String("severity", entry.Severity))
+		}
+
+		attrs := lr.Attributes()
+		attrs.PutStr("message", entry.Message)
+		attrs.PutStr("component", entry.Component)
+		attrs.PutStr("context", entry.Context)
+		attrs.PutStr("log_name", logName)
+
+		_, isSlowQueryComponent := slowQueryComponents[entry.Component]
+		for k, v := range entry.Attributes {
+			if promoted, ok := slowQueryAttributes[k]; ok && isSlowQueryComponent {
+				putSlowQueryAttribute(attrs, promoted, k, v, cfg.redactCommand)
+				continue
+			}
+			putRawAttribute(attrs, k, v)
+		}
+		if entry.ID != 0 {
+			attrs.PutInt("id", entry.ID)
+		}
+	}
+
+	return ld
+}
+
+// putSlowQueryAttribute promotes a single profiler/slow-query field to its
+// mongodb.* attribute name, additionally deriving a nanosecond "duration"
+// attribute from durationMillis and redacting the command sub-document
+// when requested.
+func putSlowQueryAttribute(attrs pcommon.Map, promotedKey, rawKey string, v interface{}, redactCommand bool) {
+	if rawKey == "command" {
+		if doc, ok := v.(map[string]interface{}); ok && redactCommand {
+			v = redactCommandDoc(doc)
+		}
+	}
+	putRawAttribute(attrs, promotedKey, v)
+
+	if rawKey == "durationMillis" {
+		if millis, ok := toInt64(v); ok {
+			attrs.PutInt("duration", millis*int64(time.Millisecond))
+		}
+	}
+}
+
+// redactCommandDoc returns a copy of doc with every leaf value replaced by
+// a placeholder naming its BSON/JSON type, so the query shape can be
+// exported without leaking the literal values bound to it.
+func redactCommandDoc(doc map[string]interface{}) map[string]interface{} {
+	redacted := make(map[string]interface{}, len(doc))
+	for k, v := range doc {
+		redacted[k] = redactValue(v)
+	}
+	return redacted
+}
+
+func redactValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		return redactCommandDoc(val)
+	case []interface{}:
+		placeholders := make([]interface{}, len(val))
+		for i, e := range val {
+			placeholders[i] = redactValue(e)
+		}
+		return placeholders
+	case string:
+		return "<string>"
+	case bool:
+		return "<bool>"
+	case float64:
+		return "<number>"
+	case int, int32, int64:
+		return "<number>"
+	case nil:
+		return "<null>"
+	default:
+		return "<unknown>"
+	}
+}
+
+func toInt64(v interface{}) (int64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return n, true
+	case int32:
+		return int64(n), true
+	case int:
+		return int64(n), true
+	case float64:
+		return int64(n), true
+	default:
+		return 0, false
+	}
+}
+
+// putRawAttribute copies an arbitrary attribute value, as decoded from
+// JSON, into a pcommon.Map attribute.
+func putRawAttribute(attrs pcommon.Map, key string, v interface{}) {
+	_ = attrs.PutEmpty(key).FromRaw(v)
+}
+
+func mongodbAuditEventToLogData(logger *zap.Logger, logs []model.AuditLog, pc ProjectContext, hostname, logName, clusterName, mongoVersion string) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	resourceAttrs := rl.Resource().Attributes()
+	resourceAttrs.PutStr("mongodb_atlas.org", pc.orgName)
+	resourceAttrs.PutStr("mongodb_atlas.project", pc.Name)
+	resourceAttrs.PutStr("mongodb_atlas.cluster", clusterName)
+	resourceAttrs.PutStr("mongodb_atlas.host.name", hostname)
+
+	sl := rl.ScopeLogs().AppendEmpty()
+	for _, entry := range logs {
+		lr := sl.LogRecords().AppendEmpty()
+		lr.SetTimestamp(parseLogTimestamp(entry.Timestamp))
+		lr.Body().SetStr(entry.Raw)
+		lr.SetSeverityNumber(plog.SeverityNumberInfo)
+		lr.SetSeverityText("INFO")
+
+		attrs := lr.Attributes()
+		attrs.PutStr("atype", entry.Type)
+		putAddressAttrs(attrs, "local", entry.Local)
+		putAddressAttrs(attrs, "remote", entry.Remote)
+		if entry.ID != nil {
+			attrs.PutStr("uuid.binary", entry.ID.Binary)
+			attrs.PutStr("uuid.type", entry.ID.Type)
+		}
+		attrs.PutStr("log_name", logName)
+		attrs.PutInt("result", entry.Result)
+
+		roles := attrs.PutEmptySlice("roles")
+		for _, role := range entry.Roles {
+			m := roles.AppendEmpty().SetEmptyMap()
+			m.PutStr("role", role.Role)
+			m.PutStr("db", role.Database)
+		}
+
+		users := attrs.PutEmptySlice("users")
+		for _, user := range entry.Users {
+			m := users.AppendEmpty().SetEmptyMap()
+			m.PutStr("user", user.User)
+			m.PutStr("db", user.Database)
+		}
+
+		if entry.Param != nil {
+			_ = attrs.PutEmpty("param").FromRaw(entry.Param)
+		}
+	}
+
+	return ld
+}
+
+func putAddressAttrs(attrs pcommon.Map, prefix string, addr model.Address) {
+	if addr.IP != nil {
+		attrs.PutStr(prefix+".ip", *addr.IP)
+	}
+	if addr.Port != nil {
+		attrs.PutInt(prefix+".port", *addr.Port)
+	}
+	if addr.SystemUser != nil {
+		attrs.PutBool(prefix+".isSystemUser", *addr.SystemUser)
+	}
+	if addr.UnixSocket != nil {
+		attrs.PutStr(prefix+".unix", *addr.UnixSocket)
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright  OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodbatlasreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbatlasreceiver"
+
+type logsOptions struct {
+	redactCommand bool
+}
+
+// LogsOption customizes how mongod log entries are converted to plog.Logs.
+type LogsOption func(*logsOptions)
+
+// WithRedactCommand replaces the values in the command sub-document of a
+// promoted slow query / profiler log entry with type placeholders, so the
+// query shape can be exported without leaking literal query values.
+func WithRedactCommand() LogsOption {
+	return func(o *logsOptions) { o.redactCommand = true }
+}
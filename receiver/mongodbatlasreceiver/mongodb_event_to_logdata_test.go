@@ -16,6 +16,7 @@ package mongodbatlasreceiver
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -227,6 +228,79 @@ func TestMongoEventToAuditLogData4_2(t *testing.T) {
 	assert.Equal(t, "RAW MESSAGE", lr.Body().Str())
 }
 
+func TestMongoEventToLogDataPromotesSlowQueryAttributes(t *testing.T) {
+	mongoevent := GetTestSlowQueryEvent()
+	pc := ProjectContext{
+		orgName: "Org",
+		Project: mongodbatlas.Project{Name: "Project"},
+	}
+
+	ld := mongodbEventToLogData(zap.NewNop(), []model.LogEntry{mongoevent}, pc, "hostname", "logName", "clusterName", "4.4")
+	lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	attrs := lr.Attributes()
+
+	assertString(t, attrs, "mongodb.query_hash", "ABCD1234")
+	assertString(t, attrs, "mongodb.plan_summary", "COLLSCAN")
+	assertInt(t, attrs, "mongodb.docs_examined", 1000)
+	assertInt(t, attrs, "mongodb.keys_examined", 0)
+	assertInt(t, attrs, "mongodb.nreturned", 1)
+	assertInt(t, attrs, "mongodb.duration_millis", 250)
+	assertInt(t, attrs, "duration", 250*int64(time.Millisecond))
+	assertString(t, attrs, "mongodb.namespace", "test.coll")
+
+	command, ok := attrs.Get("mongodb.command")
+	require.True(t, ok, "mongodb.command key does not exist")
+	assert.Equal(t, mongoevent.Attributes["command"], command.Map().AsRaw())
+
+	_, rawQueryHashExists := attrs.Get("queryHash")
+	assert.False(t, rawQueryHashExists, "raw queryHash attribute should have been promoted, not duplicated")
+}
+
+func TestMongoEventToLogDataRedactsCommand(t *testing.T) {
+	mongoevent := GetTestSlowQueryEvent()
+	pc := ProjectContext{
+		orgName: "Org",
+		Project: mongodbatlas.Project{Name: "Project"},
+	}
+
+	ld := mongodbEventToLogData(zap.NewNop(), []model.LogEntry{mongoevent}, pc, "hostname", "logName", "clusterName", "4.4", WithRedactCommand())
+	lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	attrs := lr.Attributes()
+
+	command, ok := attrs.Get("mongodb.command")
+	require.True(t, ok, "mongodb.command key does not exist")
+	assert.Equal(t, map[string]interface{}{
+		"find":   "<string>",
+		"filter": map[string]interface{}{"status": "<string>"},
+	}, command.Map().AsRaw())
+}
+
+func GetTestSlowQueryEvent() model.LogEntry {
+	return model.LogEntry{
+		Timestamp: model.LogTimestamp{
+			Date: "2022-09-12T18:10:27.215+00:00",
+		},
+		Severity:  "I",
+		Component: "COMMAND",
+		Context:   "context",
+		Message:   "Slow query",
+		Attributes: map[string]interface{}{
+			"queryHash":      "ABCD1234",
+			"planSummary":    "COLLSCAN",
+			"docsExamined":   1000,
+			"keysExamined":   0,
+			"nreturned":      1,
+			"durationMillis": 250,
+			"ns":             "test.coll",
+			"command": map[string]interface{}{
+				"find":   "coll",
+				"filter": map[string]interface{}{"status": "active"},
+			},
+		},
+		Raw: "RAW MESSAGE",
+	}
+}
+
 func GetTestEvent4_4() model.LogEntry {
 	return model.LogEntry{
 		Timestamp: model.LogTimestamp{